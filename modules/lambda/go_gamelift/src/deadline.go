@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-lambda-go/lambdacontext"
+)
+
+// callTimeoutEnvVar names the env var controlling how long a single gameliftClient call
+// is allowed to run before setDeadline cancels it.
+const callTimeoutEnvVar = "GAMELIFT_CALL_TIMEOUT_MS"
+
+// defaultCallTimeout is used when callTimeoutEnvVar is unset or invalid.
+const defaultCallTimeout = 5 * time.Second
+
+// callTimeout reads the configured per-call timeout from the environment.
+func callTimeout() time.Duration {
+	raw := os.Getenv(callTimeoutEnvVar)
+	if raw == "" {
+		return defaultCallTimeout
+	}
+	ms, err := strconv.Atoi(raw)
+	if err != nil || ms <= 0 {
+		return defaultCallTimeout
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// setDeadline derives a context bounded by whichever comes sooner: override (measured
+// from now) or the remaining Lambda invocation time reported on parent. lambdacontext
+// is consulted only to confirm parent is a real Lambda invocation context; the
+// remaining time itself comes from parent.Deadline(), which the Lambda Go runtime sets
+// to the function's configured timeout.
+func setDeadline(parent context.Context, override time.Duration) (context.Context, context.CancelFunc) {
+	overrideDeadline := time.Now().Add(override)
+
+	if _, ok := lambdacontext.FromContext(parent); ok {
+		if lambdaDeadline, ok := parent.Deadline(); ok && lambdaDeadline.Before(overrideDeadline) {
+			return context.WithDeadline(parent, lambdaDeadline)
+		}
+	}
+
+	return context.WithDeadline(parent, overrideDeadline)
+}
+
+// callWithDeadline bounds a single outbound gameliftClient call with setDeadline and
+// invokes call with the derived context. Applying the deadline per call, rather than once
+// for the whole request, keeps a single slow call from eating the request's entire time
+// budget while still letting longer operations (e.g. a waiter.Wait poll loop, which is
+// bounded by its own Timeout) make repeated calls across the life of the request.
+func callWithDeadline(ctx context.Context, call func(context.Context) error) error {
+	ctx, cancel := setDeadline(ctx, callTimeout())
+	defer cancel()
+	return call(ctx)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+)
+
+func TestSetDeadline_PrefersEarlierOverride(t *testing.T) {
+	parent, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Hour))
+	defer cancel()
+
+	ctx, deadlineCancel := setDeadline(parent, 10*time.Millisecond)
+	defer deadlineCancel()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("Expected derived context to carry a deadline")
+	}
+	if time.Until(deadline) > time.Second {
+		t.Errorf("Expected the 10ms override to win, got a deadline %s away", time.Until(deadline))
+	}
+}
+
+func TestSetDeadline_CancelStopsContext(t *testing.T) {
+	ctx, cancel := setDeadline(context.Background(), time.Minute)
+	cancel()
+
+	select {
+	case <-ctx.Done():
+	default:
+		t.Fatal("Expected ctx.Done() to be closed immediately after calling cancel")
+	}
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", ctx.Err())
+	}
+}
+
+func TestCallTimeout_DefaultsWhenUnset(t *testing.T) {
+	os.Unsetenv(callTimeoutEnvVar)
+	if got := callTimeout(); got != defaultCallTimeout {
+		t.Errorf("Expected default timeout %s, got %s", defaultCallTimeout, got)
+	}
+}
+
+func TestCallTimeout_ReadsEnvVar(t *testing.T) {
+	os.Setenv(callTimeoutEnvVar, "250")
+	defer os.Unsetenv(callTimeoutEnvVar)
+
+	if got := callTimeout(); got != 250*time.Millisecond {
+		t.Errorf("Expected 250ms, got %s", got)
+	}
+}
+
+// slowGameliftClient implements gameliftAPI with a ListFleets call that blocks until its
+// context is cancelled, simulating a GameLift API call that never returns in time.
+type slowGameliftClient struct{}
+
+func (slowGameliftClient) ListFleets(ctx context.Context, params *gamelift.ListFleetsInput, optFns ...func(*gamelift.Options)) (*gamelift.ListFleetsOutput, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+func (slowGameliftClient) DescribeFleetAttributes(ctx context.Context, params *gamelift.DescribeFleetAttributesInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetAttributesOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) CreateFleet(ctx context.Context, params *gamelift.CreateFleetInput, optFns ...func(*gamelift.Options)) (*gamelift.CreateFleetOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) UpdateFleetAttributes(ctx context.Context, params *gamelift.UpdateFleetAttributesInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateFleetAttributesOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) UpdateFleetCapacity(ctx context.Context, params *gamelift.UpdateFleetCapacityInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateFleetCapacityOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) DeleteFleet(ctx context.Context, params *gamelift.DeleteFleetInput, optFns ...func(*gamelift.Options)) (*gamelift.DeleteFleetOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) StartFleetActions(ctx context.Context, params *gamelift.StartFleetActionsInput, optFns ...func(*gamelift.Options)) (*gamelift.StartFleetActionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) StopFleetActions(ctx context.Context, params *gamelift.StopFleetActionsInput, optFns ...func(*gamelift.Options)) (*gamelift.StopFleetActionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) DescribeFleetCapacity(ctx context.Context, params *gamelift.DescribeFleetCapacityInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetCapacityOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) DescribeFleetUtilization(ctx context.Context, params *gamelift.DescribeFleetUtilizationInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetUtilizationOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) CreateGameSession(ctx context.Context, params *gamelift.CreateGameSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.CreateGameSessionOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) DescribeGameSessions(ctx context.Context, params *gamelift.DescribeGameSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeGameSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) SearchGameSessions(ctx context.Context, params *gamelift.SearchGameSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.SearchGameSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) UpdateGameSession(ctx context.Context, params *gamelift.UpdateGameSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateGameSessionOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) CreatePlayerSession(ctx context.Context, params *gamelift.CreatePlayerSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.CreatePlayerSessionOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) CreatePlayerSessions(ctx context.Context, params *gamelift.CreatePlayerSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.CreatePlayerSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) DescribePlayerSessions(ctx context.Context, params *gamelift.DescribePlayerSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribePlayerSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) StartGameSessionPlacement(ctx context.Context, params *gamelift.StartGameSessionPlacementInput, optFns ...func(*gamelift.Options)) (*gamelift.StartGameSessionPlacementOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) DescribeGameSessionPlacement(ctx context.Context, params *gamelift.DescribeGameSessionPlacementInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeGameSessionPlacementOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) StartMatchmaking(ctx context.Context, params *gamelift.StartMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.StartMatchmakingOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) DescribeMatchmaking(ctx context.Context, params *gamelift.DescribeMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeMatchmakingOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) StopMatchmaking(ctx context.Context, params *gamelift.StopMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.StopMatchmakingOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (slowGameliftClient) AcceptMatch(ctx context.Context, params *gamelift.AcceptMatchInput, optFns ...func(*gamelift.Options)) (*gamelift.AcceptMatchOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestHandler_TimesOutOnSlowClient(t *testing.T) {
+	os.Setenv(callTimeoutEnvVar, "10")
+	defer os.Unsetenv(callTimeoutEnvVar)
+
+	handler := &GameLiftLambdaHandler{gameliftClient: slowGameliftClient{}}
+	bodyJSON, _ := json.Marshal(map[string]string{"action": "list_fleets"})
+
+	response, err := handler.Handler(context.Background(), events.APIGatewayProxyRequest{
+		HTTPMethod: "POST",
+		Body:       string(bodyJSON),
+		RequestContext: events.APIGatewayProxyRequestContext{
+			RequestID: "test-request-id",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if response.StatusCode != 504 {
+		t.Fatalf("Expected status code 504, got %d", response.StatusCode)
+	}
+
+	var responseBody GameLiftResponse
+	if err := json.Unmarshal([]byte(response.Body), &responseBody); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if responseBody.Error == nil || responseBody.Error.Code != "timeout" {
+		t.Errorf("Expected error code 'timeout', got %+v", responseBody.Error)
+	}
+}
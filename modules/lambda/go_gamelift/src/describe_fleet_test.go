@@ -0,0 +1,91 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+)
+
+func TestDescribeFleet_NotFound(t *testing.T) {
+	client := &mockGameLiftClient{
+		describeFleetAttributesOutput: &gamelift.DescribeFleetAttributesOutput{
+			FleetAttributes: []types.FleetAttributes{},
+		},
+	}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":   "describe_fleet",
+		"fleet_id": "fleet-missing",
+	})
+
+	if response.Status != "error" {
+		t.Fatalf("Expected status error, got %s", response.Status)
+	}
+}
+
+func TestDescribeFleet_TooManyResults(t *testing.T) {
+	fleetID := "fleet-ambiguous"
+	client := &mockGameLiftClient{
+		describeFleetAttributesOutput: &gamelift.DescribeFleetAttributesOutput{
+			FleetAttributes: []types.FleetAttributes{
+				{FleetId: &fleetID},
+				{FleetId: &fleetID},
+			},
+		},
+	}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":   "describe_fleet",
+		"fleet_id": fleetID,
+	})
+
+	if response.Status != "error" {
+		t.Fatalf("Expected status error, got %s", response.Status)
+	}
+	if response.Error == nil || response.Error.Code != "500" {
+		t.Errorf("Expected 500 error code, got %+v", response.Error)
+	}
+}
+
+func TestDescribeFleet_SDKError(t *testing.T) {
+	client := &mockGameLiftClient{
+		describeFleetAttributesErr: errNotImplementedForTest,
+	}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":   "describe_fleet",
+		"fleet_id": "fleet-1",
+	})
+
+	if response.Status != "error" {
+		t.Fatalf("Expected status error, got %s", response.Status)
+	}
+}
+
+func TestListFleets_PaginationAndFiltering(t *testing.T) {
+	buildID := "build-1"
+	nextToken := "next-page"
+	client := &mockGameLiftClient{
+		listFleetsOutput: &gamelift.ListFleetsOutput{
+			FleetIds:  []string{"fleet-1"},
+			NextToken: &nextToken,
+		},
+	}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":   "list_fleets",
+		"build_id": buildID,
+		"limit":    10,
+	})
+
+	if response.Status != "success" {
+		t.Fatalf("Expected status success, got %s: %s", response.Status, response.Message)
+	}
+	if !response.HasMore {
+		t.Error("Expected has_more to be true when NextToken is set")
+	}
+	if response.NextToken == nil || *response.NextToken != nextToken {
+		t.Errorf("Expected next_token %q, got %v", nextToken, response.NextToken)
+	}
+}
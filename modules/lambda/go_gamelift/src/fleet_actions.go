@@ -0,0 +1,455 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+
+	"github.com/Gall-oDrone/UnrealEngine5DedicatedServersWithAWSAndGameLift/modules/lambda/go_gamelift/src/waiter"
+)
+
+// gameLiftResponseHeaders returns the standard headers shared by every GameLift API response
+func gameLiftResponseHeaders() map[string]string {
+	return map[string]string{
+		"Content-Type":                 "application/json",
+		"Access-Control-Allow-Origin":  "*",
+		"Access-Control-Allow-Headers": "Content-Type,X-Amz-Date,Authorization,X-Api-Key",
+		"Access-Control-Allow-Methods": "GET,POST,OPTIONS",
+	}
+}
+
+// jsonResponse marshals a GameLiftResponse and wraps it in an API Gateway response
+func (h *GameLiftLambdaHandler) jsonResponse(statusCode int, body GameLiftResponse, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	responseJSON, err := json.Marshal(body)
+	if err != nil {
+		return h.createErrorResponse(500, "Failed to encode response", err.Error(), request.RequestContext.RequestID), nil
+	}
+
+	return events.APIGatewayProxyResponse{
+		StatusCode: statusCode,
+		Headers:    gameLiftResponseHeaders(),
+		Body:       string(responseJSON),
+	}, nil
+}
+
+// toEC2InboundPermissions converts request-facing inbound permissions to SDK IpPermission values
+func toEC2InboundPermissions(permissions []EC2InboundPermission) []types.IpPermission {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	result := make([]types.IpPermission, 0, len(permissions))
+	for _, p := range permissions {
+		p := p
+		result = append(result, types.IpPermission{
+			FromPort: &p.FromPort,
+			ToPort:   &p.ToPort,
+			IpRange:  &p.IpRange,
+			Protocol: types.IpProtocol(p.Protocol),
+		})
+	}
+	return result
+}
+
+// toRuntimeConfiguration converts a request-facing runtime configuration to the SDK type
+func toRuntimeConfiguration(rc *RuntimeConfiguration) *types.RuntimeConfiguration {
+	if rc == nil {
+		return nil
+	}
+
+	serverProcesses := make([]types.ServerProcess, 0, len(rc.ServerProcesses))
+	for _, sp := range rc.ServerProcesses {
+		sp := sp
+		serverProcesses = append(serverProcesses, types.ServerProcess{
+			ConcurrentExecutions: &sp.ConcurrentExecutions,
+			LaunchPath:           &sp.LaunchPath,
+			Parameters:           &sp.Parameters,
+		})
+	}
+
+	return &types.RuntimeConfiguration{
+		GameSessionActivationTimeoutSeconds: &rc.GameSessionActivationTimeoutSeconds,
+		MaxConcurrentGameSessionActivations: &rc.MaxConcurrentGameSessionActivations,
+		ServerProcesses:                     serverProcesses,
+	}
+}
+
+// fleetActivationPending/Target mirror the GameLift fleet status lifecycle for create waits.
+var fleetActivationPending = []string{
+	string(types.FleetStatusNew),
+	string(types.FleetStatusDownloading),
+	string(types.FleetStatusValidating),
+	string(types.FleetStatusBuilding),
+	string(types.FleetStatusActivating),
+}
+var fleetActivationTarget = []string{
+	string(types.FleetStatusActive),
+	string(types.FleetStatusError),
+}
+
+// fleetTerminationPending/Target mirror the lifecycle for delete waits.
+var fleetTerminationPending = []string{
+	string(types.FleetStatusActive),
+	string(types.FleetStatusDeleting),
+}
+var fleetTerminationTarget = []string{
+	string(types.FleetStatusTerminated),
+}
+
+// gameliftNotFoundError adapts a GameLift "not found" API error to waiter.IsNotFound.
+type gameliftNotFoundError struct{ error }
+
+func (gameliftNotFoundError) NotFound() bool { return true }
+
+// fleetStatusFunc returns a waiter.StatusFunc that polls DescribeFleetAttributes for fleetID.
+func (h *GameLiftLambdaHandler) fleetStatusFunc(fleetID string) waiter.StatusFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
+		var result *gamelift.DescribeFleetAttributesOutput
+		err := callWithDeadline(ctx, func(ctx context.Context) error {
+			var err error
+			result, err = h.gameliftClient.DescribeFleetAttributes(ctx, &gamelift.DescribeFleetAttributesInput{
+				FleetIds: []string{fleetID},
+			})
+			return err
+		})
+		if err != nil {
+			var nfe *types.NotFoundException
+			if errors.As(err, &nfe) {
+				return nil, "", gameliftNotFoundError{err}
+			}
+			return nil, "", err
+		}
+		if len(result.FleetAttributes) == 0 {
+			return nil, "", gameliftNotFoundError{fmt.Errorf("fleet not found: %s", fleetID)}
+		}
+		return result.FleetAttributes[0], string(result.FleetAttributes[0].Status), nil
+	}
+}
+
+// waitTimeout resolves the per-request wait timeout, falling back to the waiter default.
+func waitTimeout(seconds int) time.Duration {
+	if seconds <= 0 {
+		return waiter.DefaultTimeout
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// handleCreateFleet handles CreateFleet requests
+func (h *GameLiftLambdaHandler) handleCreateFleet(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.Name == "" {
+		return h.createErrorResponse(400, "Missing required parameter: name", "", request.RequestContext.RequestID), nil
+	}
+	if body.EC2InstanceType == "" {
+		return h.createErrorResponse(400, "Missing required parameter: ec2_instance_type", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.CreateFleetInput{
+		Name:                  &body.Name,
+		EC2InstanceType:       types.EC2InstanceType(body.EC2InstanceType),
+		EC2InboundPermissions: toEC2InboundPermissions(body.EC2InboundPermissions),
+		RuntimeConfiguration:  toRuntimeConfiguration(body.RuntimeConfiguration),
+	}
+	if body.Description != "" {
+		input.Description = &body.Description
+	}
+	if body.BuildID != "" {
+		input.BuildId = &body.BuildID
+	}
+	if body.ScriptID != "" {
+		input.ScriptId = &body.ScriptID
+	}
+	if body.FleetType != "" {
+		input.FleetType = types.FleetType(body.FleetType)
+	}
+	if body.NewGameSessionProtectionPolicy != "" {
+		input.NewGameSessionProtectionPolicy = types.ProtectionPolicy(body.NewGameSessionProtectionPolicy)
+	}
+
+	var result *gamelift.CreateFleetOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.CreateFleet(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to create fleet", request.RequestContext.RequestID), nil
+	}
+
+	fleetInfo := &FleetInfo{
+		FleetId:         result.FleetAttributes.FleetId,
+		FleetArn:        result.FleetAttributes.FleetArn,
+		FleetType:       result.FleetAttributes.FleetType,
+		EC2InstanceType: result.FleetAttributes.InstanceType,
+		BuildId:         result.FleetAttributes.BuildId,
+		Status:          result.FleetAttributes.Status,
+		Description:     result.FleetAttributes.Description,
+		Name:            result.FleetAttributes.Name,
+	}
+	if result.FleetAttributes.CreationTime != nil {
+		ct := result.FleetAttributes.CreationTime.Format("2006-01-02T15:04:05Z07:00")
+		fleetInfo.CreationTime = &ct
+	}
+
+	response := GameLiftResponse{
+		Status:    "success",
+		Operation: "create_fleet",
+		Fleet:     fleetInfo,
+		Timestamp: request.RequestContext.RequestID,
+	}
+
+	if body.WaitForActive {
+		// NotFoundIsPending: DescribeFleetAttributes can briefly 404 for a fleet id
+		// CreateFleet just returned, due to eventual consistency. Keep polling through
+		// that instead of failing the wait outright.
+		conf, waitErr := waiter.Wait(ctx, waiter.Options{
+			Pending:           fleetActivationPending,
+			Target:            fleetActivationTarget,
+			Timeout:           waitTimeout(body.WaitTimeoutSeconds),
+			NotFoundIsPending: true,
+		}, h.fleetStatusFunc(*fleetInfo.FleetId))
+		if conf != nil {
+			response.ObservedStatuses = conf.ObservedStatuses
+		}
+		if waitErr != nil {
+			return h.createErrorResponse(500, "Fleet did not reach ACTIVE before the wait deadline", waitErr.Error(), request.RequestContext.RequestID), nil
+		}
+		if attrs, ok := conf.Result.(types.FleetAttributes); ok {
+			response.Fleet = fleetAttributesToInfo(attrs)
+			if attrs.Status == types.FleetStatusError {
+				return h.createErrorResponse(500, "Fleet entered ERROR state while waiting to become ACTIVE", "", request.RequestContext.RequestID), nil
+			}
+		}
+	}
+
+	return h.jsonResponse(200, response, request)
+}
+
+// handleUpdateFleetAttributes handles UpdateFleetAttributes requests
+func (h *GameLiftLambdaHandler) handleUpdateFleetAttributes(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	input := &gamelift.UpdateFleetAttributesInput{
+		FleetId: &body.FleetID,
+	}
+	if body.Name != "" {
+		input.Name = &body.Name
+	}
+	if body.Description != "" {
+		input.Description = &body.Description
+	}
+	if body.NewGameSessionProtectionPolicy != "" {
+		input.NewGameSessionProtectionPolicy = types.ProtectionPolicy(body.NewGameSessionProtectionPolicy)
+	}
+
+	var result *gamelift.UpdateFleetAttributesOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.UpdateFleetAttributes(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to update fleet attributes", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "update_fleet_attributes",
+		Fleet:     &FleetInfo{FleetId: result.FleetId},
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleUpdateFleetCapacity handles UpdateFleetCapacity requests
+func (h *GameLiftLambdaHandler) handleUpdateFleetCapacity(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	input := &gamelift.UpdateFleetCapacityInput{
+		FleetId:          &body.FleetID,
+		DesiredInstances: body.DesiredInstances,
+		MinSize:          body.MinSize,
+		MaxSize:          body.MaxSize,
+	}
+
+	var result *gamelift.UpdateFleetCapacityOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.UpdateFleetCapacity(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to update fleet capacity", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "update_fleet_capacity",
+		Fleet:     &FleetInfo{FleetId: result.FleetId},
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleDeleteFleet handles DeleteFleet requests. When body.WaitForActive is set, it blocks
+// until the fleet reaches TERMINATED (or the fleet is no longer found, which is also terminal).
+func (h *GameLiftLambdaHandler) handleDeleteFleet(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		_, err := h.gameliftClient.DeleteFleet(ctx, &gamelift.DeleteFleetInput{
+			FleetId: &body.FleetID,
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to delete fleet", request.RequestContext.RequestID), nil
+	}
+
+	response := GameLiftResponse{
+		Status:    "success",
+		Operation: "delete_fleet",
+		Fleet:     &FleetInfo{FleetId: &body.FleetID},
+		Timestamp: request.RequestContext.RequestID,
+	}
+
+	if body.WaitForActive {
+		conf, waitErr := waiter.Wait(ctx, waiter.Options{
+			Pending:          fleetTerminationPending,
+			Target:           fleetTerminationTarget,
+			Timeout:          waitTimeout(body.WaitTimeoutSeconds),
+			NotFoundIsTarget: true,
+		}, h.fleetStatusFunc(body.FleetID))
+		if conf != nil {
+			response.ObservedStatuses = conf.ObservedStatuses
+		}
+		if waitErr != nil {
+			return h.createErrorResponse(500, "Fleet did not terminate before the wait deadline", waitErr.Error(), request.RequestContext.RequestID), nil
+		}
+	}
+
+	return h.jsonResponse(200, response, request)
+}
+
+// parseFleetActions converts request-facing action names to SDK FleetAction values
+func parseFleetActions(actions []string) []types.FleetAction {
+	result := make([]types.FleetAction, 0, len(actions))
+	for _, a := range actions {
+		result = append(result, types.FleetAction(a))
+	}
+	return result
+}
+
+// handleStartFleetActions handles StartFleetActions requests
+func (h *GameLiftLambdaHandler) handleStartFleetActions(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if len(body.Actions) == 0 {
+		return h.createErrorResponse(400, "Missing required parameter: actions", "", request.RequestContext.RequestID), nil
+	}
+
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		_, err := h.gameliftClient.StartFleetActions(ctx, &gamelift.StartFleetActionsInput{
+			FleetId: &body.FleetID,
+			Actions: parseFleetActions(body.Actions),
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to start fleet actions", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "start_fleet_actions",
+		Fleet:     &FleetInfo{FleetId: &body.FleetID},
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleStopFleetActions handles StopFleetActions requests
+func (h *GameLiftLambdaHandler) handleStopFleetActions(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if len(body.Actions) == 0 {
+		return h.createErrorResponse(400, "Missing required parameter: actions", "", request.RequestContext.RequestID), nil
+	}
+
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		_, err := h.gameliftClient.StopFleetActions(ctx, &gamelift.StopFleetActionsInput{
+			FleetId: &body.FleetID,
+			Actions: parseFleetActions(body.Actions),
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to stop fleet actions", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "stop_fleet_actions",
+		Fleet:     &FleetInfo{FleetId: &body.FleetID},
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleDescribeFleetCapacity handles DescribeFleetCapacity requests
+func (h *GameLiftLambdaHandler) handleDescribeFleetCapacity(ctx context.Context, fleetID string, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var result *gamelift.DescribeFleetCapacityOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.DescribeFleetCapacity(ctx, &gamelift.DescribeFleetCapacityInput{
+			FleetIds: []string{fleetID},
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to describe fleet capacity", request.RequestContext.RequestID), nil
+	}
+	if len(result.FleetCapacity) == 0 {
+		return h.createErrorResponse(404, fmt.Sprintf("Fleet not found: %s", fleetID), "", request.RequestContext.RequestID), nil
+	}
+
+	capacity := result.FleetCapacity[0]
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "describe_fleet_capacity",
+		FleetCapacity: &FleetCapacityInfo{
+			FleetId: capacity.FleetId,
+			InstanceCounts: &FleetInstanceCounts{
+				Desired: capacity.InstanceCounts.DESIRED,
+				Minimum: capacity.InstanceCounts.MINIMUM,
+				Maximum: capacity.InstanceCounts.MAXIMUM,
+				Active:  capacity.InstanceCounts.ACTIVE,
+				Idle:    capacity.InstanceCounts.IDLE,
+			},
+		},
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleDescribeFleetUtilization handles DescribeFleetUtilization requests
+func (h *GameLiftLambdaHandler) handleDescribeFleetUtilization(ctx context.Context, fleetID string, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var result *gamelift.DescribeFleetUtilizationOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.DescribeFleetUtilization(ctx, &gamelift.DescribeFleetUtilizationInput{
+			FleetIds: []string{fleetID},
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to describe fleet utilization", request.RequestContext.RequestID), nil
+	}
+	if len(result.FleetUtilization) == 0 {
+		return h.createErrorResponse(404, fmt.Sprintf("Fleet not found: %s", fleetID), "", request.RequestContext.RequestID), nil
+	}
+
+	utilization := result.FleetUtilization[0]
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "describe_fleet_utilization",
+		FleetUtilization: &FleetUtilizationInfo{
+			FleetId:                   utilization.FleetId,
+			ActiveServerProcessCount:  utilization.ActiveServerProcessCount,
+			ActiveGameSessionCount:    utilization.ActiveGameSessionCount,
+			CurrentPlayerSessionCount: utilization.CurrentPlayerSessionCount,
+			MaximumPlayerSessionCount: utilization.MaximumPlayerSessionCount,
+		},
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
@@ -3,32 +3,77 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/gamelift"
 	"github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+
+	"github.com/Gall-oDrone/UnrealEngine5DedicatedServersWithAWSAndGameLift/modules/lambda/go_gamelift/src/tfresource"
 )
 
+// gameliftAPI is the subset of *gamelift.Client used by this handler. It lets
+// tests inject a fake client instead of talking to the real GameLift service.
+type gameliftAPI interface {
+	ListFleets(ctx context.Context, params *gamelift.ListFleetsInput, optFns ...func(*gamelift.Options)) (*gamelift.ListFleetsOutput, error)
+	DescribeFleetAttributes(ctx context.Context, params *gamelift.DescribeFleetAttributesInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetAttributesOutput, error)
+	CreateFleet(ctx context.Context, params *gamelift.CreateFleetInput, optFns ...func(*gamelift.Options)) (*gamelift.CreateFleetOutput, error)
+	UpdateFleetAttributes(ctx context.Context, params *gamelift.UpdateFleetAttributesInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateFleetAttributesOutput, error)
+	UpdateFleetCapacity(ctx context.Context, params *gamelift.UpdateFleetCapacityInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateFleetCapacityOutput, error)
+	DeleteFleet(ctx context.Context, params *gamelift.DeleteFleetInput, optFns ...func(*gamelift.Options)) (*gamelift.DeleteFleetOutput, error)
+	StartFleetActions(ctx context.Context, params *gamelift.StartFleetActionsInput, optFns ...func(*gamelift.Options)) (*gamelift.StartFleetActionsOutput, error)
+	StopFleetActions(ctx context.Context, params *gamelift.StopFleetActionsInput, optFns ...func(*gamelift.Options)) (*gamelift.StopFleetActionsOutput, error)
+	DescribeFleetCapacity(ctx context.Context, params *gamelift.DescribeFleetCapacityInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetCapacityOutput, error)
+	DescribeFleetUtilization(ctx context.Context, params *gamelift.DescribeFleetUtilizationInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetUtilizationOutput, error)
+	CreateGameSession(ctx context.Context, params *gamelift.CreateGameSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.CreateGameSessionOutput, error)
+	DescribeGameSessions(ctx context.Context, params *gamelift.DescribeGameSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeGameSessionsOutput, error)
+	SearchGameSessions(ctx context.Context, params *gamelift.SearchGameSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.SearchGameSessionsOutput, error)
+	UpdateGameSession(ctx context.Context, params *gamelift.UpdateGameSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateGameSessionOutput, error)
+	CreatePlayerSession(ctx context.Context, params *gamelift.CreatePlayerSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.CreatePlayerSessionOutput, error)
+	CreatePlayerSessions(ctx context.Context, params *gamelift.CreatePlayerSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.CreatePlayerSessionsOutput, error)
+	DescribePlayerSessions(ctx context.Context, params *gamelift.DescribePlayerSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribePlayerSessionsOutput, error)
+	StartGameSessionPlacement(ctx context.Context, params *gamelift.StartGameSessionPlacementInput, optFns ...func(*gamelift.Options)) (*gamelift.StartGameSessionPlacementOutput, error)
+	DescribeGameSessionPlacement(ctx context.Context, params *gamelift.DescribeGameSessionPlacementInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeGameSessionPlacementOutput, error)
+	StartMatchmaking(ctx context.Context, params *gamelift.StartMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.StartMatchmakingOutput, error)
+	DescribeMatchmaking(ctx context.Context, params *gamelift.DescribeMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeMatchmakingOutput, error)
+	StopMatchmaking(ctx context.Context, params *gamelift.StopMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.StopMatchmakingOutput, error)
+	AcceptMatch(ctx context.Context, params *gamelift.AcceptMatchInput, optFns ...func(*gamelift.Options)) (*gamelift.AcceptMatchOutput, error)
+}
+
 // GameLiftLambdaHandler handles Lambda requests
 type GameLiftLambdaHandler struct {
-	gameliftClient *gamelift.Client
+	gameliftClient gameliftAPI
 }
 
 // GameLiftResponse represents the API response structure
 type GameLiftResponse struct {
-	Status      string                 `json:"status"`
-	Message     string                 `json:"message,omitempty"`
-	Operation   string                 `json:"operation,omitempty"`
-	FleetCount  int                    `json:"fleet_count,omitempty"`
-	Fleets      []string               `json:"fleets,omitempty"`
-	Fleet       *FleetInfo             `json:"fleet,omitempty"`
-	NextToken   *string                `json:"next_token,omitempty"`
-	Error       *ErrorInfo             `json:"error,omitempty"`
-	Timestamp   string                 `json:"timestamp,omitempty"`
+	Status            string               `json:"status"`
+	Message           string               `json:"message,omitempty"`
+	Operation         string               `json:"operation,omitempty"`
+	FleetCount        int                  `json:"fleet_count,omitempty"`
+	Fleets            []string             `json:"fleets,omitempty"`
+	Fleet             *FleetInfo           `json:"fleet,omitempty"`
+	FleetCapacity     *FleetCapacityInfo   `json:"fleet_capacity,omitempty"`
+	FleetUtilization  *FleetUtilizationInfo `json:"fleet_utilization,omitempty"`
+	ObservedStatuses  []string             `json:"observed_statuses,omitempty"`
+	GameSession       *GameSessionInfo     `json:"game_session,omitempty"`
+	GameSessions      []GameSessionInfo    `json:"game_sessions,omitempty"`
+	PlayerSession     *PlayerSessionInfo   `json:"player_session,omitempty"`
+	PlayerSessions    []PlayerSessionInfo  `json:"player_sessions,omitempty"`
+	PlacementId       *string              `json:"placement_id,omitempty"`
+	PlacementStatus   *string              `json:"placement_status,omitempty"`
+	TicketId          *string              `json:"ticket_id,omitempty"`
+	MatchmakingStatus *string              `json:"matchmaking_status,omitempty"`
+	NotificationTarget *string             `json:"notification_target,omitempty"`
+	NextToken         *string              `json:"next_token,omitempty"`
+	HasMore           bool                 `json:"has_more,omitempty"`
+	Error             *ErrorInfo           `json:"error,omitempty"`
+	Timestamp         string               `json:"timestamp,omitempty"`
 }
 
 // FleetInfo represents fleet information
@@ -53,19 +98,150 @@ type ErrorInfo struct {
 
 // GameLiftRequest represents the incoming request
 type GameLiftRequest struct {
-	Action  string `json:"action"`
+	Action string `json:"action"`
+
 	FleetID string `json:"fleet_id"`
+
+	// create_fleet
+	Name                        string                    `json:"name,omitempty"`
+	Description                 string                    `json:"description,omitempty"`
+	BuildID                     string                    `json:"build_id,omitempty"`
+	ScriptID                    string                    `json:"script_id,omitempty"`
+	EC2InstanceType             string                    `json:"ec2_instance_type,omitempty"`
+	FleetType                   string                    `json:"fleet_type,omitempty"`
+	EC2InboundPermissions       []EC2InboundPermission    `json:"ec2_inbound_permissions,omitempty"`
+	NewGameSessionProtectionPolicy string                 `json:"new_game_session_protection_policy,omitempty"`
+	RuntimeConfiguration        *RuntimeConfiguration     `json:"runtime_configuration,omitempty"`
+
+	// list_fleets filtering
+	FleetStatus string `json:"fleet_status,omitempty"`
+
+	// update_fleet_capacity
+	DesiredInstances *int32 `json:"desired_instances,omitempty"`
+	MinSize          *int32 `json:"min_size,omitempty"`
+	MaxSize          *int32 `json:"max_size,omitempty"`
+
+	// start_fleet_actions / stop_fleet_actions
+	Actions []string `json:"actions,omitempty"`
+
+	// create_fleet / delete_fleet: block until the fleet reaches a terminal state
+	WaitForActive     bool `json:"wait_for_active,omitempty"`
+	WaitTimeoutSeconds int `json:"wait_timeout_seconds,omitempty"`
+
+	// game session / player session actions
+	GameSessionID             string            `json:"game_session_id,omitempty"`
+	GameSessionName           string            `json:"game_session_name,omitempty"`
+	GameSessionQueueName      string            `json:"game_session_queue_name,omitempty"`
+	MaximumPlayerSessionCount int32             `json:"maximum_player_session_count,omitempty"`
+	GameProperties            map[string]string `json:"game_properties,omitempty"`
+	PlayerID                  string            `json:"player_id,omitempty"`
+	PlayerIDs                 []string          `json:"player_ids,omitempty"`
+	PlayerData                string            `json:"player_data,omitempty"`
+	PlayerDataMap             map[string]string `json:"player_data_map,omitempty"`
+	SearchExpression          string            `json:"search_expression,omitempty"`
+	SortExpression            string            `json:"sort_expression,omitempty"`
+	PlacementID               string            `json:"placement_id,omitempty"`
+	PlacementPlayerLatencies  map[string]int32  `json:"placement_player_latencies,omitempty"`
+	NextToken                 string            `json:"next_token,omitempty"`
+	Limit                     int32             `json:"limit,omitempty"`
+
+	// matchmaking actions
+	MatchmakingConfigurationName string  `json:"matchmaking_configuration_name,omitempty"`
+	Players                      []Player `json:"players,omitempty"`
+	TicketId                     string  `json:"ticket_id,omitempty"`
+	AcceptanceType               string  `json:"acceptance_type,omitempty"`
+}
+
+// Player represents a FlexMatch player, including the attributes used for rule evaluation.
+type Player struct {
+	PlayerId         string                    `json:"player_id"`
+	Team             string                    `json:"team,omitempty"`
+	PlayerAttributes map[string]AttributeValue `json:"player_attributes,omitempty"`
+	LatencyInMs      map[string]int32          `json:"latency_in_ms,omitempty"`
+}
+
+// AttributeValue mirrors gamelift.types.AttributeValue, supporting exactly one of its
+// string/number/string-list/string-double-map variants per FlexMatch player attribute.
+type AttributeValue struct {
+	S   *string            `json:"S,omitempty"`
+	N   *float64           `json:"N,omitempty"`
+	SL  []string           `json:"SL,omitempty"`
+	SDM map[string]float64 `json:"SDM,omitempty"`
+}
+
+// EC2InboundPermission mirrors gamelift.types.IpPermission for JSON requests
+type EC2InboundPermission struct {
+	FromPort int32  `json:"from_port"`
+	ToPort   int32  `json:"to_port"`
+	IpRange  string `json:"ip_range"`
+	Protocol string `json:"protocol"`
+}
+
+// RuntimeConfiguration mirrors gamelift.types.RuntimeConfiguration for JSON requests
+type RuntimeConfiguration struct {
+	GameSessionActivationTimeoutSeconds int32           `json:"game_session_activation_timeout_seconds,omitempty"`
+	MaxConcurrentGameSessionActivations int32           `json:"max_concurrent_game_session_activations,omitempty"`
+	ServerProcesses                     []ServerProcess `json:"server_processes,omitempty"`
 }
 
-// Handler is the main Lambda handler function for GameLift operations
+// ServerProcess mirrors gamelift.types.ServerProcess for JSON requests
+type ServerProcess struct {
+	ConcurrentExecutions int32  `json:"concurrent_executions"`
+	LaunchPath           string `json:"launch_path"`
+	Parameters           string `json:"parameters,omitempty"`
+}
+
+// FleetCapacityInfo represents fleet instance capacity information
+type FleetCapacityInfo struct {
+	FleetId        *string              `json:"FleetId"`
+	InstanceCounts *FleetInstanceCounts `json:"InstanceCounts"`
+}
+
+// FleetInstanceCounts represents the breakdown of a fleet's instance counts
+type FleetInstanceCounts struct {
+	Desired *int32 `json:"DESIRED"`
+	Minimum *int32 `json:"MINIMUM"`
+	Maximum *int32 `json:"MAXIMUM"`
+	Active  *int32 `json:"ACTIVE"`
+	Idle    *int32 `json:"IDLE"`
+}
+
+// FleetUtilizationInfo represents fleet utilization information
+type FleetUtilizationInfo struct {
+	FleetId                  *string `json:"FleetId"`
+	ActiveServerProcessCount *int32  `json:"ActiveServerProcessCount"`
+	ActiveGameSessionCount   *int32  `json:"ActiveGameSessionCount"`
+	CurrentPlayerSessionCount *int32 `json:"CurrentPlayerSessionCount"`
+	MaximumPlayerSessionCount *int32 `json:"MaximumPlayerSessionCount"`
+}
+
+// Handler is the main Lambda handler function for GameLift operations. It dispatches on the
+// raw Lambda context; individual action handlers bound their own outbound gameliftClient
+// calls via callWithDeadline, so a slow call can only eat its own per-call timeout rather
+// than the handler's entire dispatch, including any waiter.Wait poll loop it runs.
 func (h *GameLiftLambdaHandler) Handler(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	return h.dispatch(ctx, request)
+}
+
+// errorResponseForCallErr converts an outbound gameliftClient call error into a response,
+// surfacing the standard 504 timeout response when callWithDeadline's own deadline was the
+// cause instead of a generic 500.
+func (h *GameLiftLambdaHandler) errorResponseForCallErr(err error, message string, requestID string) events.APIGatewayProxyResponse {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return h.createTimeoutResponse(requestID)
+	}
+	return h.createErrorResponse(500, message, err.Error(), requestID)
+}
+
+// dispatch routes the request to the appropriate action handler.
+func (h *GameLiftLambdaHandler) dispatch(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	// Get request details
 	httpMethod := request.HTTPMethod
 
 	// Handle different HTTP methods
 	switch httpMethod {
 	case "GET":
-		return h.handleListFleets(ctx, request)
+		return h.handleListFleets(ctx, gameLiftRequestFromQuery(request.QueryStringParameters), request)
 
 	case "POST":
 		// Parse request body
@@ -76,12 +252,75 @@ func (h *GameLiftLambdaHandler) Handler(ctx context.Context, request events.APIG
 
 		switch bodyData.Action {
 		case "list_fleets":
-			return h.handleListFleets(ctx, request)
+			return h.handleListFleets(ctx, bodyData, request)
 		case "describe_fleet":
 			if bodyData.FleetID == "" {
 				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
 			}
 			return h.handleDescribeFleet(ctx, bodyData.FleetID, request)
+		case "create_fleet":
+			return h.handleCreateFleet(ctx, bodyData, request)
+		case "update_fleet_attributes":
+			if bodyData.FleetID == "" {
+				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+			}
+			return h.handleUpdateFleetAttributes(ctx, bodyData, request)
+		case "update_fleet_capacity":
+			if bodyData.FleetID == "" {
+				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+			}
+			return h.handleUpdateFleetCapacity(ctx, bodyData, request)
+		case "delete_fleet":
+			if bodyData.FleetID == "" {
+				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+			}
+			return h.handleDeleteFleet(ctx, bodyData, request)
+		case "start_fleet_actions":
+			if bodyData.FleetID == "" {
+				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+			}
+			return h.handleStartFleetActions(ctx, bodyData, request)
+		case "stop_fleet_actions":
+			if bodyData.FleetID == "" {
+				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+			}
+			return h.handleStopFleetActions(ctx, bodyData, request)
+		case "describe_fleet_capacity":
+			if bodyData.FleetID == "" {
+				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+			}
+			return h.handleDescribeFleetCapacity(ctx, bodyData.FleetID, request)
+		case "describe_fleet_utilization":
+			if bodyData.FleetID == "" {
+				return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+			}
+			return h.handleDescribeFleetUtilization(ctx, bodyData.FleetID, request)
+		case "create_game_session":
+			return h.handleCreateGameSession(ctx, bodyData, request)
+		case "describe_game_sessions":
+			return h.handleDescribeGameSessions(ctx, bodyData, request)
+		case "search_game_sessions":
+			return h.handleSearchGameSessions(ctx, bodyData, request)
+		case "update_game_session":
+			return h.handleUpdateGameSession(ctx, bodyData, request)
+		case "create_player_session":
+			return h.handleCreatePlayerSession(ctx, bodyData, request)
+		case "create_player_sessions":
+			return h.handleCreatePlayerSessions(ctx, bodyData, request)
+		case "describe_player_sessions":
+			return h.handleDescribePlayerSessions(ctx, bodyData, request)
+		case "start_game_session_placement":
+			return h.handleStartGameSessionPlacement(ctx, bodyData, request)
+		case "describe_game_session_placement":
+			return h.handleDescribeGameSessionPlacement(ctx, bodyData, request)
+		case "start_matchmaking":
+			return h.handleStartMatchmaking(ctx, bodyData, request)
+		case "describe_matchmaking":
+			return h.handleDescribeMatchmaking(ctx, bodyData, request)
+		case "stop_matchmaking":
+			return h.handleStopMatchmaking(ctx, bodyData, request)
+		case "accept_match":
+			return h.handleAcceptMatch(ctx, bodyData, request)
 		default:
 			return h.createErrorResponse(400, fmt.Sprintf("Unknown action: %s", bodyData.Action), "", request.RequestContext.RequestID), nil
 		}
@@ -91,71 +330,138 @@ func (h *GameLiftLambdaHandler) Handler(ctx context.Context, request events.APIG
 	}
 }
 
-// handleListFleets handles ListFleets request
-func (h *GameLiftLambdaHandler) handleListFleets(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+// gameLiftRequestFromQuery builds a GameLiftRequest from GET query string parameters,
+// since GET requests (unlike POST) carry no JSON body to unmarshal filters from.
+func gameLiftRequestFromQuery(params map[string]string) GameLiftRequest {
+	body := GameLiftRequest{Action: "list_fleets"}
+	body.NextToken = params["next_token"]
+	body.BuildID = params["build_id"]
+	body.ScriptID = params["script_id"]
+	body.FleetStatus = params["fleet_status"]
+	if limit, err := strconv.Atoi(params["limit"]); err == nil {
+		body.Limit = int32(limit)
+	}
+	return body
+}
+
+// handleListFleets handles ListFleets requests, forwarding pagination and filtering
+// parameters and reporting whether more results are available via NextToken/HasMore.
+func (h *GameLiftLambdaHandler) handleListFleets(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	input := &gamelift.ListFleetsInput{}
+	if body.BuildID != "" {
+		input.BuildId = &body.BuildID
+	}
+	if body.ScriptID != "" {
+		input.ScriptId = &body.ScriptID
+	}
+	if body.NextToken != "" {
+		input.NextToken = &body.NextToken
+	}
+	if body.Limit > 0 {
+		input.Limit = &body.Limit
+	}
+
 	// Call GameLift ListFleets API
-	result, err := h.gameliftClient.ListFleets(ctx, &gamelift.ListFleetsInput{})
+	var result *gamelift.ListFleetsOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.ListFleets(ctx, input)
+		return err
+	})
 	if err != nil {
-		return h.createErrorResponse(500, "Failed to list fleets", err.Error(), request.RequestContext.RequestID), nil
+		return h.errorResponseForCallErr(err, "Failed to list fleets", request.RequestContext.RequestID), nil
+	}
+
+	fleetIds := result.FleetIds
+	if body.FleetStatus != "" && len(fleetIds) > 0 {
+		// ListFleets has no status filter of its own; narrow the page with a follow-up
+		// DescribeFleetAttributes call and drop fleets that don't match.
+		var attrs *gamelift.DescribeFleetAttributesOutput
+		err := callWithDeadline(ctx, func(ctx context.Context) error {
+			var err error
+			attrs, err = h.gameliftClient.DescribeFleetAttributes(ctx, &gamelift.DescribeFleetAttributesInput{
+				FleetIds: fleetIds,
+			})
+			return err
+		})
+		if err != nil {
+			return h.errorResponseForCallErr(err, "Failed to filter fleets by status", request.RequestContext.RequestID), nil
+		}
+		filtered := make([]string, 0, len(attrs.FleetAttributes))
+		for _, fa := range attrs.FleetAttributes {
+			if string(fa.Status) == body.FleetStatus && fa.FleetId != nil {
+				filtered = append(filtered, *fa.FleetId)
+			}
+		}
+		fleetIds = filtered
 	}
 
-	// Prepare response
-	responseBody := GameLiftResponse{
+	return h.jsonResponse(200, GameLiftResponse{
 		Status:     "success",
 		Operation:  "list_fleets",
-		FleetCount: len(result.FleetIds),
-		Fleets:     result.FleetIds,
+		FleetCount: len(fleetIds),
+		Fleets:     fleetIds,
 		NextToken:  result.NextToken,
+		HasMore:    result.NextToken != nil && *result.NextToken != "",
 		Timestamp:  request.RequestContext.RequestID,
-	}
-
-	// Marshal response to JSON
-	responseJSON, err := json.Marshal(responseBody)
-	if err != nil {
-		return h.createErrorResponse(500, "Failed to encode response", err.Error(), request.RequestContext.RequestID), nil
-	}
-
-	// Return API Gateway response
-	return events.APIGatewayProxyResponse{
-		StatusCode: 200,
-		Headers: map[string]string{
-			"Content-Type":                 "application/json",
-			"Access-Control-Allow-Origin":  "*",
-			"Access-Control-Allow-Headers": "Content-Type,X-Amz-Date,Authorization,X-Api-Key",
-			"Access-Control-Allow-Methods": "GET,POST,OPTIONS",
-		},
-		Body: string(responseJSON),
-	}, nil
+	}, request)
 }
 
-// handleDescribeFleet handles DescribeFleetAttributes request
+// handleDescribeFleet handles DescribeFleetAttributes requests. It uses
+// tfresource.AssertSingleValueResult to collapse the FleetAttributes slice, distinguishing
+// "not found" (404) from "GameLift returned more than one fleet for this id" (500).
 func (h *GameLiftLambdaHandler) handleDescribeFleet(ctx context.Context, fleetID string, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	// Call GameLift DescribeFleetAttributes API
-	result, err := h.gameliftClient.DescribeFleetAttributes(ctx, &gamelift.DescribeFleetAttributesInput{
-		FleetIds: []string{fleetID},
+	var result *gamelift.DescribeFleetAttributesOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.DescribeFleetAttributes(ctx, &gamelift.DescribeFleetAttributesInput{
+			FleetIds: []string{fleetID},
+		})
+		return err
 	})
 	if err != nil {
-		return h.createErrorResponse(500, "Failed to describe fleet", err.Error(), request.RequestContext.RequestID), nil
+		return h.errorResponseForCallErr(err, "Failed to describe fleet", request.RequestContext.RequestID), nil
 	}
 
-	if len(result.FleetAttributes) == 0 {
-		return h.createErrorResponse(404, fmt.Sprintf("Fleet not found: %s", fleetID), "", request.RequestContext.RequestID), nil
+	fleetAttribute, err := tfresource.AssertSingleValueResult(result.FleetAttributes)
+	if err != nil {
+		var emptyErr *tfresource.EmptyResultError
+		var tooManyErr *tfresource.TooManyResultsError
+		switch {
+		case errors.As(err, &emptyErr):
+			return h.createErrorResponse(404, fmt.Sprintf("Fleet not found: %s", fleetID), "", request.RequestContext.RequestID), nil
+		case errors.As(err, &tooManyErr):
+			return h.createErrorResponse(500, fmt.Sprintf("Fleet id %s is ambiguous", fleetID), err.Error(), request.RequestContext.RequestID), nil
+		default:
+			return h.createErrorResponse(500, "Failed to describe fleet", err.Error(), request.RequestContext.RequestID), nil
+		}
 	}
 
 	// Convert fleet to FleetInfo
-	fleetAttribute := result.FleetAttributes[0]
+	fleetInfo := fleetAttributesToInfo(*fleetAttribute)
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "describe_fleet",
+		Fleet:     fleetInfo,
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
+
+// fleetAttributesToInfo converts SDK fleet attributes to the response-facing FleetInfo
+func fleetAttributesToInfo(fleetAttribute types.FleetAttributes) *FleetInfo {
 	fleetInfo := &FleetInfo{
 		FleetId:         fleetAttribute.FleetId,
 		FleetArn:        fleetAttribute.FleetArn,
 		FleetType:       fleetAttribute.FleetType,
-		EC2InstanceType: fleetAttribute.EC2InstanceType,
+		EC2InstanceType: fleetAttribute.InstanceType,
 		BuildId:         fleetAttribute.BuildId,
 		Status:          fleetAttribute.Status,
 		Description:     fleetAttribute.Description,
 		Name:            fleetAttribute.Name,
 	}
 
-	// Format timestamps if available
 	if fleetAttribute.CreationTime != nil {
 		ct := fleetAttribute.CreationTime.Format("2006-01-02T15:04:05Z07:00")
 		fleetInfo.CreationTime = &ct
@@ -165,31 +471,29 @@ func (h *GameLiftLambdaHandler) handleDescribeFleet(ctx context.Context, fleetID
 		fleetInfo.TerminationTime = &tt
 	}
 
-	// Prepare response
-	responseBody := GameLiftResponse{
-		Status:     "success",
-		Operation:  "describe_fleet",
-		Fleet:      fleetInfo,
-		Timestamp:  request.RequestContext.RequestID,
-	}
+	return fleetInfo
+}
 
-	// Marshal response to JSON
-	responseJSON, err := json.Marshal(responseBody)
-	if err != nil {
-		return h.createErrorResponse(500, "Failed to encode response", err.Error(), request.RequestContext.RequestID), nil
+// createTimeoutResponse creates the standardized 504 returned when a callWithDeadline
+// deadline elapses before its gameliftClient call completes.
+func (h *GameLiftLambdaHandler) createTimeoutResponse(timestamp string) events.APIGatewayProxyResponse {
+	errorBody := GameLiftResponse{
+		Status:  "error",
+		Message: "Request timed out before completing",
+		Error: &ErrorInfo{
+			Code:    "timeout",
+			Message: "The GameLift call did not complete before the configured deadline",
+		},
+		Timestamp: timestamp,
 	}
 
-	// Return API Gateway response
+	responseJSON, _ := json.Marshal(errorBody)
+
 	return events.APIGatewayProxyResponse{
-		StatusCode: 200,
-		Headers: map[string]string{
-			"Content-Type":                 "application/json",
-			"Access-Control-Allow-Origin":  "*",
-			"Access-Control-Allow-Headers": "Content-Type,X-Amz-Date,Authorization,X-Api-Key",
-			"Access-Control-Allow-Methods": "GET,POST,OPTIONS",
-		},
-		Body: string(responseJSON),
-	}, nil
+		StatusCode: 504,
+		Headers:    gameLiftResponseHeaders(),
+		Body:       string(responseJSON),
+	}
 }
 
 // createErrorResponse creates standardized error response
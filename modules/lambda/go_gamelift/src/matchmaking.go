@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+)
+
+// matchmakingNotificationTargetEnvVar names the env var pointing at the SNS topic or
+// EventBridge bus downstream services subscribe to for FlexMatch ticket events.
+const matchmakingNotificationTargetEnvVar = "GAMELIFT_MATCHMAKING_NOTIFICATION_TARGET"
+
+// matchmakingNotificationTarget returns the configured notification target, if any.
+func matchmakingNotificationTarget() *string {
+	if target := os.Getenv(matchmakingNotificationTargetEnvVar); target != "" {
+		return &target
+	}
+	return nil
+}
+
+// toSDKAttributeValue converts a request-facing AttributeValue to the SDK type. Exactly one
+// of S, N, SL, SDM is expected to be set, matching FlexMatch's own AttributeValue contract.
+func toSDKAttributeValue(v AttributeValue) types.AttributeValue {
+	switch {
+	case v.S != nil:
+		return types.AttributeValue{S: v.S}
+	case v.N != nil:
+		return types.AttributeValue{N: v.N}
+	case v.SL != nil:
+		return types.AttributeValue{SL: v.SL}
+	case v.SDM != nil:
+		return types.AttributeValue{SDM: v.SDM}
+	default:
+		return types.AttributeValue{}
+	}
+}
+
+// toSDKPlayers converts request-facing players to the SDK type.
+func toSDKPlayers(players []Player) []types.Player {
+	result := make([]types.Player, 0, len(players))
+	for _, p := range players {
+		p := p
+		player := types.Player{
+			PlayerId: &p.PlayerId,
+		}
+		if p.Team != "" {
+			player.Team = &p.Team
+		}
+		if len(p.PlayerAttributes) > 0 {
+			attrs := make(map[string]types.AttributeValue, len(p.PlayerAttributes))
+			for k, v := range p.PlayerAttributes {
+				attrs[k] = toSDKAttributeValue(v)
+			}
+			player.PlayerAttributes = attrs
+		}
+		if len(p.LatencyInMs) > 0 {
+			player.LatencyInMs = p.LatencyInMs
+		}
+		result = append(result, player)
+	}
+	return result
+}
+
+// handleStartMatchmaking handles StartMatchmaking requests
+func (h *GameLiftLambdaHandler) handleStartMatchmaking(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.MatchmakingConfigurationName == "" {
+		return h.createErrorResponse(400, "Missing required parameter: matchmaking_configuration_name", "", request.RequestContext.RequestID), nil
+	}
+	if len(body.Players) == 0 {
+		return h.createErrorResponse(400, "Missing required parameter: players", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.StartMatchmakingInput{
+		ConfigurationName: &body.MatchmakingConfigurationName,
+		Players:           toSDKPlayers(body.Players),
+	}
+	if body.TicketId != "" {
+		input.TicketId = &body.TicketId
+	}
+
+	var result *gamelift.StartMatchmakingOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.StartMatchmaking(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to start matchmaking", request.RequestContext.RequestID), nil
+	}
+
+	status := string(result.MatchmakingTicket.Status)
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:             "success",
+		Operation:          "start_matchmaking",
+		TicketId:           result.MatchmakingTicket.TicketId,
+		MatchmakingStatus:  &status,
+		NotificationTarget: matchmakingNotificationTarget(),
+		Timestamp:          request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleDescribeMatchmaking handles DescribeMatchmaking requests
+func (h *GameLiftLambdaHandler) handleDescribeMatchmaking(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.TicketId == "" {
+		return h.createErrorResponse(400, "Missing required parameter: ticket_id", "", request.RequestContext.RequestID), nil
+	}
+
+	var result *gamelift.DescribeMatchmakingOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.DescribeMatchmaking(ctx, &gamelift.DescribeMatchmakingInput{
+			TicketIds: []string{body.TicketId},
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to describe matchmaking", request.RequestContext.RequestID), nil
+	}
+	if len(result.TicketList) == 0 {
+		return h.createErrorResponse(404, fmt.Sprintf("Matchmaking ticket not found: %s", body.TicketId), "", request.RequestContext.RequestID), nil
+	}
+
+	ticket := result.TicketList[0]
+	status := string(ticket.Status)
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:            "success",
+		Operation:         "describe_matchmaking",
+		TicketId:          ticket.TicketId,
+		MatchmakingStatus: &status,
+		Timestamp:         request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleStopMatchmaking handles StopMatchmaking requests
+func (h *GameLiftLambdaHandler) handleStopMatchmaking(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.TicketId == "" {
+		return h.createErrorResponse(400, "Missing required parameter: ticket_id", "", request.RequestContext.RequestID), nil
+	}
+
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		_, err := h.gameliftClient.StopMatchmaking(ctx, &gamelift.StopMatchmakingInput{
+			TicketId: &body.TicketId,
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to stop matchmaking", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "stop_matchmaking",
+		TicketId:  &body.TicketId,
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleAcceptMatch handles AcceptMatch requests
+func (h *GameLiftLambdaHandler) handleAcceptMatch(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.TicketId == "" {
+		return h.createErrorResponse(400, "Missing required parameter: ticket_id", "", request.RequestContext.RequestID), nil
+	}
+	if len(body.PlayerIDs) == 0 {
+		return h.createErrorResponse(400, "Missing required parameter: player_ids", "", request.RequestContext.RequestID), nil
+	}
+	if body.AcceptanceType == "" {
+		return h.createErrorResponse(400, "Missing required parameter: acceptance_type", "", request.RequestContext.RequestID), nil
+	}
+
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		_, err := h.gameliftClient.AcceptMatch(ctx, &gamelift.AcceptMatchInput{
+			TicketId:       &body.TicketId,
+			PlayerIds:      body.PlayerIDs,
+			AcceptanceType: types.AcceptanceType(body.AcceptanceType),
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to accept match", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:    "success",
+		Operation: "accept_match",
+		TicketId:  &body.TicketId,
+		Timestamp: request.RequestContext.RequestID,
+	}, request)
+}
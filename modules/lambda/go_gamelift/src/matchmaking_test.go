@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+)
+
+// mockGameLiftClient implements gameliftAPI with scriptable matchmaking, ListFleets and
+// DescribeFleetAttributes responses; every other method returns an error if ever called.
+type mockGameLiftClient struct {
+	startMatchmakingOutput *gamelift.StartMatchmakingOutput
+	startMatchmakingErr    error
+
+	describeMatchmakingOutput *gamelift.DescribeMatchmakingOutput
+	describeMatchmakingErr    error
+
+	stopMatchmakingErr error
+	acceptMatchErr     error
+
+	describeFleetAttributesOutput *gamelift.DescribeFleetAttributesOutput
+	describeFleetAttributesErr    error
+
+	listFleetsOutput *gamelift.ListFleetsOutput
+	listFleetsErr    error
+}
+
+// errNotImplementedForTest is a shared sentinel for tests exercising the generic
+// "SDK call failed" error path, where the specific error value doesn't matter.
+var errNotImplementedForTest = errors.New("simulated SDK failure")
+
+func (m *mockGameLiftClient) ListFleets(ctx context.Context, params *gamelift.ListFleetsInput, optFns ...func(*gamelift.Options)) (*gamelift.ListFleetsOutput, error) {
+	if m.listFleetsOutput != nil || m.listFleetsErr != nil {
+		return m.listFleetsOutput, m.listFleetsErr
+	}
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) DescribeFleetAttributes(ctx context.Context, params *gamelift.DescribeFleetAttributesInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetAttributesOutput, error) {
+	if m.describeFleetAttributesOutput != nil || m.describeFleetAttributesErr != nil {
+		return m.describeFleetAttributesOutput, m.describeFleetAttributesErr
+	}
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) CreateFleet(ctx context.Context, params *gamelift.CreateFleetInput, optFns ...func(*gamelift.Options)) (*gamelift.CreateFleetOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) UpdateFleetAttributes(ctx context.Context, params *gamelift.UpdateFleetAttributesInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateFleetAttributesOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) UpdateFleetCapacity(ctx context.Context, params *gamelift.UpdateFleetCapacityInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateFleetCapacityOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) DeleteFleet(ctx context.Context, params *gamelift.DeleteFleetInput, optFns ...func(*gamelift.Options)) (*gamelift.DeleteFleetOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) StartFleetActions(ctx context.Context, params *gamelift.StartFleetActionsInput, optFns ...func(*gamelift.Options)) (*gamelift.StartFleetActionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) StopFleetActions(ctx context.Context, params *gamelift.StopFleetActionsInput, optFns ...func(*gamelift.Options)) (*gamelift.StopFleetActionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) DescribeFleetCapacity(ctx context.Context, params *gamelift.DescribeFleetCapacityInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetCapacityOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) DescribeFleetUtilization(ctx context.Context, params *gamelift.DescribeFleetUtilizationInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeFleetUtilizationOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) CreateGameSession(ctx context.Context, params *gamelift.CreateGameSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.CreateGameSessionOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) DescribeGameSessions(ctx context.Context, params *gamelift.DescribeGameSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeGameSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) SearchGameSessions(ctx context.Context, params *gamelift.SearchGameSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.SearchGameSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) UpdateGameSession(ctx context.Context, params *gamelift.UpdateGameSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.UpdateGameSessionOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) CreatePlayerSession(ctx context.Context, params *gamelift.CreatePlayerSessionInput, optFns ...func(*gamelift.Options)) (*gamelift.CreatePlayerSessionOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) CreatePlayerSessions(ctx context.Context, params *gamelift.CreatePlayerSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.CreatePlayerSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) DescribePlayerSessions(ctx context.Context, params *gamelift.DescribePlayerSessionsInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribePlayerSessionsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) StartGameSessionPlacement(ctx context.Context, params *gamelift.StartGameSessionPlacementInput, optFns ...func(*gamelift.Options)) (*gamelift.StartGameSessionPlacementOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) DescribeGameSessionPlacement(ctx context.Context, params *gamelift.DescribeGameSessionPlacementInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeGameSessionPlacementOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockGameLiftClient) StartMatchmaking(ctx context.Context, params *gamelift.StartMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.StartMatchmakingOutput, error) {
+	return m.startMatchmakingOutput, m.startMatchmakingErr
+}
+func (m *mockGameLiftClient) DescribeMatchmaking(ctx context.Context, params *gamelift.DescribeMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.DescribeMatchmakingOutput, error) {
+	return m.describeMatchmakingOutput, m.describeMatchmakingErr
+}
+func (m *mockGameLiftClient) StopMatchmaking(ctx context.Context, params *gamelift.StopMatchmakingInput, optFns ...func(*gamelift.Options)) (*gamelift.StopMatchmakingOutput, error) {
+	return &gamelift.StopMatchmakingOutput{}, m.stopMatchmakingErr
+}
+func (m *mockGameLiftClient) AcceptMatch(ctx context.Context, params *gamelift.AcceptMatchInput, optFns ...func(*gamelift.Options)) (*gamelift.AcceptMatchOutput, error) {
+	return &gamelift.AcceptMatchOutput{}, m.acceptMatchErr
+}
+
+func invokeMatchmaking(t *testing.T, client *mockGameLiftClient, body interface{}) GameLiftResponse {
+	t.Helper()
+
+	handler := &GameLiftLambdaHandler{gameliftClient: client}
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("Failed to marshal request body: %v", err)
+	}
+
+	response, err := handler.Handler(context.Background(), events.APIGatewayProxyRequest{
+		HTTPMethod: "POST",
+		Body:       string(bodyJSON),
+		RequestContext: events.APIGatewayProxyRequestContext{
+			RequestID: "test-request-id",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	var responseBody GameLiftResponse
+	if err := json.Unmarshal([]byte(response.Body), &responseBody); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	return responseBody
+}
+
+func TestStartMatchmaking_TicketLifecycle(t *testing.T) {
+	ticketID := "ticket-123"
+	client := &mockGameLiftClient{
+		startMatchmakingOutput: &gamelift.StartMatchmakingOutput{
+			MatchmakingTicket: &types.MatchmakingTicket{
+				TicketId: &ticketID,
+				Status:   types.MatchmakingConfigurationStatusQueued,
+			},
+		},
+	}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":                          "start_matchmaking",
+		"matchmaking_configuration_name": "test-config",
+		"players": []map[string]interface{}{
+			{"player_id": "player-1"},
+		},
+	})
+
+	if response.Status != "success" {
+		t.Fatalf("Expected status success, got %s: %s", response.Status, response.Message)
+	}
+	if response.TicketId == nil || *response.TicketId != ticketID {
+		t.Errorf("Expected ticket id %q, got %v", ticketID, response.TicketId)
+	}
+}
+
+func TestDescribeMatchmaking_NotFound(t *testing.T) {
+	client := &mockGameLiftClient{
+		describeMatchmakingOutput: &gamelift.DescribeMatchmakingOutput{},
+	}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":    "describe_matchmaking",
+		"ticket_id": "missing-ticket",
+	})
+
+	if response.Status != "error" {
+		t.Fatalf("Expected status error, got %s", response.Status)
+	}
+}
+
+func TestStopMatchmaking_Success(t *testing.T) {
+	client := &mockGameLiftClient{}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":    "stop_matchmaking",
+		"ticket_id": "ticket-123",
+	})
+
+	if response.Status != "success" {
+		t.Fatalf("Expected status success, got %s: %s", response.Status, response.Message)
+	}
+}
+
+func TestAcceptMatch_Success(t *testing.T) {
+	client := &mockGameLiftClient{}
+
+	response := invokeMatchmaking(t, client, map[string]interface{}{
+		"action":          "accept_match",
+		"ticket_id":       "ticket-123",
+		"player_ids":      []string{"player-1"},
+		"acceptance_type": "ACCEPT",
+	})
+
+	if response.Status != "success" {
+		t.Fatalf("Expected status success, got %s: %s", response.Status, response.Message)
+	}
+}
+
+func TestPlayerAttributes_StringNumberListAndMapMarshalling(t *testing.T) {
+	name := "warrior"
+	players := []Player{
+		{
+			PlayerId: "player-1",
+			PlayerAttributes: map[string]AttributeValue{
+				"class": {S: &name},
+			},
+		},
+	}
+	skill := 42.0
+	players[0].PlayerAttributes["skill"] = AttributeValue{N: &skill}
+	players[0].PlayerAttributes["tags"] = AttributeValue{SL: []string{"ranked", "eu"}}
+	players[0].PlayerAttributes["scores"] = AttributeValue{SDM: map[string]float64{"kills": 10, "deaths": 2}}
+
+	sdkPlayers := toSDKPlayers(players)
+	if len(sdkPlayers) != 1 {
+		t.Fatalf("Expected 1 SDK player, got %d", len(sdkPlayers))
+	}
+
+	attrs := sdkPlayers[0].PlayerAttributes
+	if attrs["class"].S == nil || *attrs["class"].S != "warrior" {
+		t.Errorf("Expected class=warrior, got %v", attrs["class"])
+	}
+	if attrs["skill"].N == nil || *attrs["skill"].N != 42.0 {
+		t.Errorf("Expected skill=42, got %v", attrs["skill"].N)
+	}
+	if len(attrs["tags"].SL) != 2 {
+		t.Errorf("Expected 2 tags, got %v", attrs["tags"].SL)
+	}
+	if attrs["scores"].SDM["kills"] != 10 {
+		t.Errorf("Expected kills=10, got %v", attrs["scores"].SDM)
+	}
+}
@@ -0,0 +1,455 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+)
+
+// GameSessionInfo represents game session information
+type GameSessionInfo struct {
+	GameSessionId             *string           `json:"GameSessionId"`
+	FleetId                   *string           `json:"FleetId"`
+	Name                      *string           `json:"Name"`
+	Status                    types.GameSessionStatus `json:"Status"`
+	CurrentPlayerSessionCount *int32            `json:"CurrentPlayerSessionCount"`
+	MaximumPlayerSessionCount *int32            `json:"MaximumPlayerSessionCount"`
+	IpAddress                 *string           `json:"IpAddress,omitempty"`
+	Port                      *int32            `json:"Port,omitempty"`
+	GameProperties            map[string]string `json:"GameProperties,omitempty"`
+	CreationTime              *string           `json:"CreationTime,omitempty"`
+	TerminationTime           *string           `json:"TerminationTime,omitempty"`
+}
+
+// PlayerSessionInfo represents player session information
+type PlayerSessionInfo struct {
+	PlayerSessionId *string                     `json:"PlayerSessionId"`
+	PlayerId        *string                     `json:"PlayerId"`
+	GameSessionId   *string                     `json:"GameSessionId"`
+	FleetId         *string                     `json:"FleetId"`
+	IpAddress       *string                     `json:"IpAddress,omitempty"`
+	Port            *int32                      `json:"Port,omitempty"`
+	Status          types.PlayerSessionStatus   `json:"Status"`
+	CreationTime    *string                     `json:"CreationTime,omitempty"`
+}
+
+// gamePropertiesToSDK converts a flat request map to GameLift's GameProperty list
+func gamePropertiesToSDK(props map[string]string) []types.GameProperty {
+	if len(props) == 0 {
+		return nil
+	}
+	result := make([]types.GameProperty, 0, len(props))
+	for k, v := range props {
+		key, value := k, v
+		result = append(result, types.GameProperty{Key: &key, Value: &value})
+	}
+	return result
+}
+
+// gamePropertiesFromSDK converts GameLift's GameProperty list to a flat response map
+func gamePropertiesFromSDK(props []types.GameProperty) map[string]string {
+	if len(props) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(props))
+	for _, p := range props {
+		if p.Key != nil && p.Value != nil {
+			result[*p.Key] = *p.Value
+		}
+	}
+	return result
+}
+
+// gameSessionToInfo converts an SDK GameSession to the response-facing GameSessionInfo
+func gameSessionToInfo(gs types.GameSession) *GameSessionInfo {
+	info := &GameSessionInfo{
+		GameSessionId:             gs.GameSessionId,
+		FleetId:                   gs.FleetId,
+		Name:                      gs.Name,
+		Status:                    gs.Status,
+		CurrentPlayerSessionCount: gs.CurrentPlayerSessionCount,
+		MaximumPlayerSessionCount: gs.MaximumPlayerSessionCount,
+		IpAddress:                 gs.IpAddress,
+		Port:                      gs.Port,
+		GameProperties:            gamePropertiesFromSDK(gs.GameProperties),
+	}
+	if gs.CreationTime != nil {
+		ct := gs.CreationTime.Format("2006-01-02T15:04:05Z07:00")
+		info.CreationTime = &ct
+	}
+	if gs.TerminationTime != nil {
+		tt := gs.TerminationTime.Format("2006-01-02T15:04:05Z07:00")
+		info.TerminationTime = &tt
+	}
+	return info
+}
+
+// playerSessionToInfo converts an SDK PlayerSession to the response-facing PlayerSessionInfo
+func playerSessionToInfo(ps types.PlayerSession) *PlayerSessionInfo {
+	info := &PlayerSessionInfo{
+		PlayerSessionId: ps.PlayerSessionId,
+		PlayerId:        ps.PlayerId,
+		GameSessionId:   ps.GameSessionId,
+		FleetId:         ps.FleetId,
+		IpAddress:       ps.IpAddress,
+		Port:            ps.Port,
+		Status:          ps.Status,
+	}
+	if ps.CreationTime != nil {
+		ct := ps.CreationTime.Format("2006-01-02T15:04:05Z07:00")
+		info.CreationTime = &ct
+	}
+	return info
+}
+
+// handleCreateGameSession handles CreateGameSession requests
+func (h *GameLiftLambdaHandler) handleCreateGameSession(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.FleetID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+	}
+	if body.MaximumPlayerSessionCount == 0 {
+		return h.createErrorResponse(400, "Missing required parameter: maximum_player_session_count", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.CreateGameSessionInput{
+		FleetId:                   &body.FleetID,
+		MaximumPlayerSessionCount: &body.MaximumPlayerSessionCount,
+		GameProperties:            gamePropertiesToSDK(body.GameProperties),
+	}
+	if body.GameSessionName != "" {
+		input.Name = &body.GameSessionName
+	}
+
+	var result *gamelift.CreateGameSessionOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.CreateGameSession(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to create game session", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:      "success",
+		Operation:   "create_game_session",
+		GameSession: gameSessionToInfo(*result.GameSession),
+		Timestamp:   request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleDescribeGameSessions handles DescribeGameSessions requests
+func (h *GameLiftLambdaHandler) handleDescribeGameSessions(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	input := &gamelift.DescribeGameSessionsInput{}
+	if body.FleetID != "" {
+		input.FleetId = &body.FleetID
+	}
+	if body.GameSessionID != "" {
+		input.GameSessionId = &body.GameSessionID
+	}
+	if body.NextToken != "" {
+		input.NextToken = &body.NextToken
+	}
+	if body.Limit > 0 {
+		input.Limit = &body.Limit
+	}
+
+	var result *gamelift.DescribeGameSessionsOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.DescribeGameSessions(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to describe game sessions", request.RequestContext.RequestID), nil
+	}
+
+	sessions := make([]GameSessionInfo, 0, len(result.GameSessions))
+	for _, gs := range result.GameSessions {
+		sessions = append(sessions, *gameSessionToInfo(gs))
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:       "success",
+		Operation:    "describe_game_sessions",
+		GameSessions: sessions,
+		NextToken:    result.NextToken,
+		Timestamp:    request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleSearchGameSessions handles SearchGameSessions requests
+func (h *GameLiftLambdaHandler) handleSearchGameSessions(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.FleetID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: fleet_id", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.SearchGameSessionsInput{
+		FleetId: &body.FleetID,
+	}
+	if body.SearchExpression != "" {
+		input.FilterExpression = &body.SearchExpression
+	}
+	if body.SortExpression != "" {
+		input.SortExpression = &body.SortExpression
+	}
+	if body.NextToken != "" {
+		input.NextToken = &body.NextToken
+	}
+	if body.Limit > 0 {
+		input.Limit = &body.Limit
+	}
+
+	var result *gamelift.SearchGameSessionsOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.SearchGameSessions(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to search game sessions", request.RequestContext.RequestID), nil
+	}
+
+	sessions := make([]GameSessionInfo, 0, len(result.GameSessions))
+	for _, gs := range result.GameSessions {
+		sessions = append(sessions, *gameSessionToInfo(gs))
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:       "success",
+		Operation:    "search_game_sessions",
+		GameSessions: sessions,
+		NextToken:    result.NextToken,
+		Timestamp:    request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleUpdateGameSession handles UpdateGameSession requests
+func (h *GameLiftLambdaHandler) handleUpdateGameSession(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.GameSessionID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: game_session_id", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.UpdateGameSessionInput{
+		GameSessionId: &body.GameSessionID,
+	}
+	if body.GameSessionName != "" {
+		input.Name = &body.GameSessionName
+	}
+	if body.MaximumPlayerSessionCount > 0 {
+		input.MaximumPlayerSessionCount = &body.MaximumPlayerSessionCount
+	}
+
+	var result *gamelift.UpdateGameSessionOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.UpdateGameSession(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to update game session", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:      "success",
+		Operation:   "update_game_session",
+		GameSession: gameSessionToInfo(*result.GameSession),
+		Timestamp:   request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleCreatePlayerSession handles CreatePlayerSession requests
+func (h *GameLiftLambdaHandler) handleCreatePlayerSession(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.GameSessionID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: game_session_id", "", request.RequestContext.RequestID), nil
+	}
+	if body.PlayerID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: player_id", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.CreatePlayerSessionInput{
+		GameSessionId: &body.GameSessionID,
+		PlayerId:      &body.PlayerID,
+	}
+	if body.PlayerData != "" {
+		input.PlayerData = &body.PlayerData
+	}
+
+	var result *gamelift.CreatePlayerSessionOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.CreatePlayerSession(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to create player session", request.RequestContext.RequestID), nil
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:        "success",
+		Operation:     "create_player_session",
+		PlayerSession: playerSessionToInfo(*result.PlayerSession),
+		Timestamp:     request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleCreatePlayerSessions handles CreatePlayerSessions (batch) requests
+func (h *GameLiftLambdaHandler) handleCreatePlayerSessions(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.GameSessionID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: game_session_id", "", request.RequestContext.RequestID), nil
+	}
+	if len(body.PlayerIDs) == 0 {
+		return h.createErrorResponse(400, "Missing required parameter: player_ids", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.CreatePlayerSessionsInput{
+		GameSessionId: &body.GameSessionID,
+		PlayerIds:     body.PlayerIDs,
+	}
+	if len(body.PlayerDataMap) > 0 {
+		input.PlayerDataMap = body.PlayerDataMap
+	}
+
+	var result *gamelift.CreatePlayerSessionsOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.CreatePlayerSessions(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to create player sessions", request.RequestContext.RequestID), nil
+	}
+
+	sessions := make([]PlayerSessionInfo, 0, len(result.PlayerSessions))
+	for _, ps := range result.PlayerSessions {
+		sessions = append(sessions, *playerSessionToInfo(ps))
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:         "success",
+		Operation:      "create_player_sessions",
+		PlayerSessions: sessions,
+		Timestamp:      request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleDescribePlayerSessions handles DescribePlayerSessions requests
+func (h *GameLiftLambdaHandler) handleDescribePlayerSessions(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	input := &gamelift.DescribePlayerSessionsInput{}
+	if body.GameSessionID != "" {
+		input.GameSessionId = &body.GameSessionID
+	}
+	if body.PlayerID != "" {
+		input.PlayerId = &body.PlayerID
+	}
+	if body.NextToken != "" {
+		input.NextToken = &body.NextToken
+	}
+	if body.Limit > 0 {
+		input.Limit = &body.Limit
+	}
+
+	var result *gamelift.DescribePlayerSessionsOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.DescribePlayerSessions(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to describe player sessions", request.RequestContext.RequestID), nil
+	}
+
+	sessions := make([]PlayerSessionInfo, 0, len(result.PlayerSessions))
+	for _, ps := range result.PlayerSessions {
+		sessions = append(sessions, *playerSessionToInfo(ps))
+	}
+
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:         "success",
+		Operation:      "describe_player_sessions",
+		PlayerSessions: sessions,
+		NextToken:      result.NextToken,
+		Timestamp:      request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleStartGameSessionPlacement handles StartGameSessionPlacement requests
+func (h *GameLiftLambdaHandler) handleStartGameSessionPlacement(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.PlacementID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: placement_id", "", request.RequestContext.RequestID), nil
+	}
+	if body.GameSessionQueueName == "" {
+		return h.createErrorResponse(400, "Missing required parameter: game_session_queue_name", "", request.RequestContext.RequestID), nil
+	}
+	if body.MaximumPlayerSessionCount == 0 {
+		return h.createErrorResponse(400, "Missing required parameter: maximum_player_session_count", "", request.RequestContext.RequestID), nil
+	}
+
+	input := &gamelift.StartGameSessionPlacementInput{
+		PlacementId:               &body.PlacementID,
+		GameSessionQueueName:      &body.GameSessionQueueName,
+		MaximumPlayerSessionCount: &body.MaximumPlayerSessionCount,
+		GameProperties:            gamePropertiesToSDK(body.GameProperties),
+	}
+	if len(body.PlacementPlayerLatencies) > 0 {
+		latencies := make([]types.PlayerLatency, 0, len(body.PlacementPlayerLatencies))
+		for playerID, ms := range body.PlacementPlayerLatencies {
+			id, latencyMs := playerID, float32(ms)
+			latencies = append(latencies, types.PlayerLatency{PlayerId: &id, LatencyInMilliseconds: latencyMs})
+		}
+		input.PlayerLatencies = latencies
+	}
+
+	var result *gamelift.StartGameSessionPlacementOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.StartGameSessionPlacement(ctx, input)
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to start game session placement", request.RequestContext.RequestID), nil
+	}
+
+	status := string(result.GameSessionPlacement.Status)
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:          "success",
+		Operation:       "start_game_session_placement",
+		PlacementId:     result.GameSessionPlacement.PlacementId,
+		PlacementStatus: &status,
+		Timestamp:       request.RequestContext.RequestID,
+	}, request)
+}
+
+// handleDescribeGameSessionPlacement handles DescribeGameSessionPlacement requests
+func (h *GameLiftLambdaHandler) handleDescribeGameSessionPlacement(ctx context.Context, body GameLiftRequest, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	if body.PlacementID == "" {
+		return h.createErrorResponse(400, "Missing required parameter: placement_id", "", request.RequestContext.RequestID), nil
+	}
+
+	var result *gamelift.DescribeGameSessionPlacementOutput
+	err := callWithDeadline(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.gameliftClient.DescribeGameSessionPlacement(ctx, &gamelift.DescribeGameSessionPlacementInput{
+			PlacementId: &body.PlacementID,
+		})
+		return err
+	})
+	if err != nil {
+		return h.errorResponseForCallErr(err, "Failed to describe game session placement", request.RequestContext.RequestID), nil
+	}
+	if result.GameSessionPlacement == nil {
+		return h.createErrorResponse(404, fmt.Sprintf("Placement not found: %s", body.PlacementID), "", request.RequestContext.RequestID), nil
+	}
+
+	status := string(result.GameSessionPlacement.Status)
+	return h.jsonResponse(200, GameLiftResponse{
+		Status:          "success",
+		Operation:       "describe_game_session_placement",
+		PlacementId:     result.GameSessionPlacement.PlacementId,
+		PlacementStatus: &status,
+		Timestamp:       request.RequestContext.RequestID,
+	}, request)
+}
@@ -0,0 +1,36 @@
+// Package tfresource provides small generic helpers for collapsing AWS SDK list
+// results, modeled on the terraform-provider-aws internal package of the same name.
+package tfresource
+
+import "fmt"
+
+// EmptyResultError indicates a list/describe call returned zero results where exactly
+// one was expected.
+type EmptyResultError struct{}
+
+func (e *EmptyResultError) Error() string {
+	return "empty result"
+}
+
+// TooManyResultsError indicates a list/describe call returned more than one result
+// where exactly one was expected.
+type TooManyResultsError struct {
+	Count int
+}
+
+func (e *TooManyResultsError) Error() string {
+	return fmt.Sprintf("too many results: got %d, expected 1", e.Count)
+}
+
+// AssertSingleValueResult collapses a slice to its single element, returning a
+// typed error when the slice is empty or has more than one element.
+func AssertSingleValueResult[T any](values []T) (*T, error) {
+	switch l := len(values); {
+	case l == 0:
+		return nil, &EmptyResultError{}
+	case l > 1:
+		return nil, &TooManyResultsError{Count: l}
+	default:
+		return &values[0], nil
+	}
+}
@@ -0,0 +1,35 @@
+package tfresource
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAssertSingleValueResult_Single(t *testing.T) {
+	value, err := AssertSingleValueResult([]string{"only"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if value == nil || *value != "only" {
+		t.Errorf("Expected value 'only', got %v", value)
+	}
+}
+
+func TestAssertSingleValueResult_Empty(t *testing.T) {
+	_, err := AssertSingleValueResult([]string{})
+	var emptyErr *EmptyResultError
+	if !errors.As(err, &emptyErr) {
+		t.Errorf("Expected EmptyResultError, got %v", err)
+	}
+}
+
+func TestAssertSingleValueResult_TooMany(t *testing.T) {
+	_, err := AssertSingleValueResult([]string{"a", "b"})
+	var tooManyErr *TooManyResultsError
+	if !errors.As(err, &tooManyErr) {
+		t.Errorf("Expected TooManyResultsError, got %v", err)
+	}
+	if tooManyErr.Count != 2 {
+		t.Errorf("Expected count 2, got %d", tooManyErr.Count)
+	}
+}
@@ -0,0 +1,150 @@
+// Package waiter provides a generic poll-with-backoff helper for waiting on
+// GameLift resources to reach a terminal state, modeled on the
+// terraform-provider-aws GameLift status/wait helpers.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Default backoff parameters used when a request does not override them.
+const (
+	DefaultInitialDelay = 2 * time.Second
+	DefaultBackoffFactor = 1.5
+	DefaultMaxDelay      = 30 * time.Second
+	DefaultTimeout       = 2 * time.Minute
+)
+
+// ErrTimeout is returned when the deadline elapses before a terminal status is observed.
+var ErrTimeout = errors.New("waiter: timed out waiting for terminal status")
+
+// StatusFunc polls the current state of a resource and reports its status string.
+// result carries whatever resource payload the caller wants to keep once the
+// wait completes (e.g. the last DescribeFleetAttributes output).
+type StatusFunc func(ctx context.Context) (result interface{}, status string, err error)
+
+// Options configures a single Wait invocation.
+type Options struct {
+	// Pending statuses are treated as "keep polling".
+	Pending []string
+	// Target statuses are treated as successful terminal states.
+	Target []string
+	// Timeout bounds the total time spent polling. Defaults to DefaultTimeout.
+	Timeout time.Duration
+	// InitialDelay is the delay before the first re-poll. Defaults to DefaultInitialDelay.
+	InitialDelay time.Duration
+	// BackoffFactor multiplies the delay after every poll. Defaults to DefaultBackoffFactor.
+	BackoffFactor float64
+	// MaxDelay caps the delay between polls. Defaults to DefaultMaxDelay.
+	MaxDelay time.Duration
+	// NotFoundIsTarget treats a "NotFound" status (see IsNotFound) as a successful
+	// terminal state instead of an error. Used by delete waits.
+	NotFoundIsTarget bool
+	// NotFoundIsPending treats a "NotFound" status (see IsNotFound) as "keep polling"
+	// instead of an error. Used by create waits, where the resource can briefly 404
+	// between the create call returning and the first status poll observing it.
+	NotFoundIsPending bool
+}
+
+// StateChangeConf is returned from Wait to aid debugging: the sequence of
+// statuses observed in order, including the final one.
+type StateChangeConf struct {
+	Result           interface{}
+	ObservedStatuses []string
+}
+
+// Wait polls statusFunc with exponential backoff until it reports a status in
+// opts.Target, opts.Timeout elapses, or ctx is done. It returns the last
+// result along with every status observed, in order, for debuggability.
+func Wait(ctx context.Context, opts Options, statusFunc StatusFunc) (*StateChangeConf, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	delay := opts.InitialDelay
+	if delay <= 0 {
+		delay = DefaultInitialDelay
+	}
+	factor := opts.BackoffFactor
+	if factor <= 0 {
+		factor = DefaultBackoffFactor
+	}
+	maxDelay := opts.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxDelay
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conf := &StateChangeConf{}
+
+	for {
+		result, status, err := statusFunc(ctx)
+		if err != nil {
+			if opts.NotFoundIsTarget && IsNotFound(err) {
+				conf.Result = result
+				conf.ObservedStatuses = append(conf.ObservedStatuses, "NotFound")
+				return conf, nil
+			}
+			if opts.NotFoundIsPending && IsNotFound(err) {
+				conf.ObservedStatuses = append(conf.ObservedStatuses, "NotFound")
+				select {
+				case <-ctx.Done():
+					return conf, ErrTimeout
+				case <-time.After(delay):
+				}
+				delay = time.Duration(float64(delay) * factor)
+				if delay > maxDelay {
+					delay = maxDelay
+				}
+				continue
+			}
+			return conf, err
+		}
+
+		conf.Result = result
+		conf.ObservedStatuses = append(conf.ObservedStatuses, status)
+
+		if contains(opts.Target, status) {
+			return conf, nil
+		}
+		if !contains(opts.Pending, status) {
+			return conf, fmt.Errorf("waiter: unexpected status %q", status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return conf, ErrTimeout
+		case <-time.After(delay):
+		}
+
+		delay = time.Duration(float64(delay) * factor)
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// IsNotFound reports whether err represents a "resource not found" condition.
+// Callers pass the SDK error through; any error in the chain with a
+// NotFound() bool method (as test errors can define) is checked.
+func IsNotFound(err error) bool {
+	var nf interface{ NotFound() bool }
+	if errors.As(err, &nf) {
+		return nf.NotFound()
+	}
+	return false
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string { return "not found" }
+func (notFoundError) NotFound() bool { return true }
+
+func TestWaitReachesTarget(t *testing.T) {
+	statuses := []string{"NEW", "ACTIVATING", "ACTIVE"}
+	call := 0
+	statusFunc := func(ctx context.Context) (interface{}, string, error) {
+		status := statuses[call]
+		if call < len(statuses)-1 {
+			call++
+		}
+		return status, status, nil
+	}
+
+	conf, err := Wait(context.Background(), Options{
+		Pending:       []string{"NEW", "ACTIVATING"},
+		Target:        []string{"ACTIVE"},
+		Timeout:       time.Second,
+		InitialDelay:  time.Millisecond,
+		BackoffFactor: 1.0,
+		MaxDelay:      time.Millisecond,
+	}, statusFunc)
+	if err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if conf.Result != "ACTIVE" {
+		t.Errorf("Expected final result ACTIVE, got %v", conf.Result)
+	}
+	if len(conf.ObservedStatuses) != len(statuses) {
+		t.Errorf("Expected %d observed statuses, got %d: %v", len(statuses), len(conf.ObservedStatuses), conf.ObservedStatuses)
+	}
+}
+
+func TestWaitTerminalError(t *testing.T) {
+	statusFunc := func(ctx context.Context) (interface{}, string, error) {
+		return nil, "ERROR", nil
+	}
+
+	_, err := Wait(context.Background(), Options{
+		Pending:       []string{"NEW"},
+		Target:        []string{"ACTIVE"},
+		Timeout:       time.Second,
+		InitialDelay:  time.Millisecond,
+		BackoffFactor: 1.0,
+		MaxDelay:      time.Millisecond,
+	}, statusFunc)
+	if err == nil {
+		t.Fatal("Expected an error for unexpected terminal status, got nil")
+	}
+}
+
+func TestWaitTimesOut(t *testing.T) {
+	statusFunc := func(ctx context.Context) (interface{}, string, error) {
+		return nil, "ACTIVATING", nil
+	}
+
+	_, err := Wait(context.Background(), Options{
+		Pending:       []string{"ACTIVATING"},
+		Target:        []string{"ACTIVE"},
+		Timeout:       5 * time.Millisecond,
+		InitialDelay:  2 * time.Millisecond,
+		BackoffFactor: 1.0,
+		MaxDelay:      2 * time.Millisecond,
+	}, statusFunc)
+	if !errors.Is(err, ErrTimeout) {
+		t.Errorf("Expected ErrTimeout, got %v", err)
+	}
+}
+
+func TestWaitNotFoundIsTargetForDelete(t *testing.T) {
+	statusFunc := func(ctx context.Context) (interface{}, string, error) {
+		return nil, "", notFoundError{}
+	}
+
+	conf, err := Wait(context.Background(), Options{
+		Pending:          []string{"ACTIVE"},
+		Target:           []string{"TERMINATED"},
+		Timeout:          time.Second,
+		InitialDelay:     time.Millisecond,
+		NotFoundIsTarget: true,
+	}, statusFunc)
+	if err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if len(conf.ObservedStatuses) != 1 || conf.ObservedStatuses[0] != "NotFound" {
+		t.Errorf("Expected observed statuses [NotFound], got %v", conf.ObservedStatuses)
+	}
+}
+
+func TestWaitNotFoundIsPendingForCreate(t *testing.T) {
+	statuses := []string{"", "", "ACTIVE"}
+	call := 0
+	statusFunc := func(ctx context.Context) (interface{}, string, error) {
+		status := statuses[call]
+		if call < len(statuses)-1 {
+			call++
+		}
+		if status == "" {
+			return nil, "", notFoundError{}
+		}
+		return status, status, nil
+	}
+
+	conf, err := Wait(context.Background(), Options{
+		Pending:           []string{"ACTIVATING"},
+		Target:            []string{"ACTIVE"},
+		Timeout:           time.Second,
+		InitialDelay:      time.Millisecond,
+		BackoffFactor:     1.0,
+		MaxDelay:          time.Millisecond,
+		NotFoundIsPending: true,
+	}, statusFunc)
+	if err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if conf.Result != "ACTIVE" {
+		t.Errorf("Expected final result ACTIVE, got %v", conf.Result)
+	}
+	want := []string{"NotFound", "NotFound", "ACTIVE"}
+	if len(conf.ObservedStatuses) != len(want) {
+		t.Fatalf("Expected observed statuses %v, got %v", want, conf.ObservedStatuses)
+	}
+	for i, status := range want {
+		if conf.ObservedStatuses[i] != status {
+			t.Errorf("Expected observed status %d to be %q, got %q", i, status, conf.ObservedStatuses[i])
+		}
+	}
+}